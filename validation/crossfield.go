@@ -0,0 +1,155 @@
+package validation
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// EqField function validates that the form field values equal the value of another form field.
+func EqField(otherField string, message ...string) Validation {
+	return func(form url.Values, values ...string) error {
+		other := form.Get(otherField)
+
+		for _, val := range values {
+			if val == other {
+				continue
+			}
+
+			return newError("eq_field", Params{"value": val, "other": otherField}, message...)
+		}
+
+		return nil
+	}
+}
+
+// NeField function validates that the form field values differ from the value of another form field.
+func NeField(otherField string, message ...string) Validation {
+	return func(form url.Values, values ...string) error {
+		other := form.Get(otherField)
+
+		for _, val := range values {
+			if val != other {
+				continue
+			}
+
+			return newError("ne_field", Params{"value": val, "other": otherField}, message...)
+		}
+
+		return nil
+	}
+}
+
+// GtField function validates that the form field values are greater than the value of another form field.
+func GtField(otherField string, message ...string) Validation {
+	return func(form url.Values, values ...string) error {
+		other, err := strconv.ParseFloat(form.Get(otherField), 64)
+		if err != nil {
+			return newError("not_a_number", Params{"value": form.Get(otherField)}, message...)
+		}
+
+		for _, val := range values {
+			n, err := strconv.ParseFloat(val, 64)
+			if err != nil {
+				return newError("not_a_number", Params{"value": val}, message...)
+			}
+
+			if n > other {
+				continue
+			}
+
+			return newError("gt_field", Params{"value": val, "other": otherField}, message...)
+		}
+
+		return nil
+	}
+}
+
+// LtField function validates that the form field values are less than the value of another form field.
+func LtField(otherField string, message ...string) Validation {
+	return func(form url.Values, values ...string) error {
+		other, err := strconv.ParseFloat(form.Get(otherField), 64)
+		if err != nil {
+			return newError("not_a_number", Params{"value": form.Get(otherField)}, message...)
+		}
+
+		for _, val := range values {
+			n, err := strconv.ParseFloat(val, 64)
+			if err != nil {
+				return newError("not_a_number", Params{"value": val}, message...)
+			}
+
+			if n < other {
+				continue
+			}
+
+			return newError("lt_field", Params{"value": val, "other": otherField}, message...)
+		}
+
+		return nil
+	}
+}
+
+// RequiredIf function validates that the form field has no-empty values when another
+// form field equals otherValue.
+func RequiredIf(otherField, otherValue string, message ...string) Validation {
+	return func(form url.Values, values ...string) error {
+		if form.Get(otherField) != otherValue {
+			return nil
+		}
+
+		return Required(message...)(form, values...)
+	}
+}
+
+// RequiredUnless function validates that the form field has no-empty values unless another
+// form field equals otherValue.
+func RequiredUnless(otherField, otherValue string, message ...string) Validation {
+	return func(form url.Values, values ...string) error {
+		if form.Get(otherField) == otherValue {
+			return nil
+		}
+
+		return Required(message...)(form, values...)
+	}
+}
+
+// ExcludedIf function validates that the form field has no values when another form field
+// equals otherValue.
+func ExcludedIf(otherField, otherValue string, message ...string) Validation {
+	return func(form url.Values, values ...string) error {
+		if form.Get(otherField) != otherValue {
+			return nil
+		}
+
+		for _, val := range values {
+			if strings.TrimSpace(val) == "" {
+				continue
+			}
+
+			return newError("excluded_if", Params{"other": otherField, "otherValue": otherValue}, message...)
+		}
+
+		return nil
+	}
+}
+
+// ExcludedUnless function validates that the form field has no values unless another form
+// field equals otherValue.
+func ExcludedUnless(otherField, otherValue string, message ...string) Validation {
+	return func(form url.Values, values ...string) error {
+		if form.Get(otherField) == otherValue {
+			return nil
+		}
+
+		for _, val := range values {
+			if strings.TrimSpace(val) == "" {
+				continue
+			}
+
+			return newError("excluded_unless", Params{"other": otherField, "otherValue": otherValue}, message...)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,102 @@
+package validation
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestEqField(t *testing.T) {
+	form := url.Values{"password": {"secret"}, "confirm": {"secret"}}
+	if err := EqField("password")(form, form["confirm"]...); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	form.Set("confirm", "other")
+	if err := EqField("password")(form, form["confirm"]...); err == nil {
+		t.Fatal("expected an error for mismatched fields")
+	}
+}
+
+func TestNeField(t *testing.T) {
+	form := url.Values{"old_password": {"secret"}, "new_password": {"secret"}}
+	if err := NeField("old_password")(form, form["new_password"]...); err == nil {
+		t.Fatal("expected an error when the fields are equal")
+	}
+
+	form.Set("new_password", "different")
+	if err := NeField("old_password")(form, form["new_password"]...); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestGtField(t *testing.T) {
+	form := url.Values{"min_price": {"10"}, "max_price": {"20"}}
+	if err := GtField("min_price")(form, form["max_price"]...); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	form.Set("max_price", "5")
+	if err := GtField("min_price")(form, form["max_price"]...); err == nil {
+		t.Fatal("expected an error when max_price is not greater than min_price")
+	}
+}
+
+func TestLtField(t *testing.T) {
+	form := url.Values{"min_price": {"10"}, "max_price": {"20"}}
+	if err := LtField("max_price")(form, form["min_price"]...); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	form.Set("min_price", "25")
+	if err := LtField("max_price")(form, form["min_price"]...); err == nil {
+		t.Fatal("expected an error when min_price is not less than max_price")
+	}
+}
+
+func TestRequiredIf(t *testing.T) {
+	form := url.Values{"shipping_method": {"freight"}}
+	if err := RequiredIf("shipping_method", "freight")(form, ""); err == nil {
+		t.Fatal("expected an error since shipping_method matches and the field is empty")
+	}
+
+	form.Set("shipping_method", "pickup")
+	if err := RequiredIf("shipping_method", "freight")(form, ""); err != nil {
+		t.Fatalf("expected no error when shipping_method doesn't match, got %v", err)
+	}
+}
+
+func TestRequiredUnless(t *testing.T) {
+	form := url.Values{"shipping_method": {"pickup"}}
+	if err := RequiredUnless("shipping_method", "pickup")(form, ""); err != nil {
+		t.Fatalf("expected no error since shipping_method matches, got %v", err)
+	}
+
+	form.Set("shipping_method", "freight")
+	if err := RequiredUnless("shipping_method", "pickup")(form, ""); err == nil {
+		t.Fatal("expected an error since shipping_method doesn't match and the field is empty")
+	}
+}
+
+func TestExcludedIf(t *testing.T) {
+	form := url.Values{"plan": {"free"}}
+	if err := ExcludedIf("plan", "free")(form, "truck"); err == nil {
+		t.Fatal("expected an error since plan matches and the field has a value")
+	}
+
+	form.Set("plan", "pro")
+	if err := ExcludedIf("plan", "free")(form, "truck"); err != nil {
+		t.Fatalf("expected no error when plan doesn't match, got %v", err)
+	}
+}
+
+func TestExcludedUnless(t *testing.T) {
+	form := url.Values{"plan": {"pro"}}
+	if err := ExcludedUnless("plan", "pro")(form, "truck"); err != nil {
+		t.Fatalf("expected no error since plan matches, got %v", err)
+	}
+
+	form.Set("plan", "free")
+	if err := ExcludedUnless("plan", "pro")(form, "truck"); err == nil {
+		t.Fatal("expected an error since plan doesn't match and the field has a value")
+	}
+}
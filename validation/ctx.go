@@ -0,0 +1,139 @@
+package validation
+
+import (
+	"context"
+	"net/url"
+	"sync"
+)
+
+// parallelThreshold is the ruleset size above which NewCtx evaluates rules concurrently,
+// across a bounded pool of workers, instead of serially in the calling goroutine. Below it,
+// the overhead of spawning workers outweighs the work of evaluating a handful of rules.
+const parallelThreshold = 50
+
+// maxWorkers bounds how many rules a large ruleset evaluates at once, so a form with
+// thousands of rules doesn't spawn a goroutine per rule. Validations are typically I/O-bound
+// (a DB-backed uniqueness check, say), not CPU-bound, so this is sized for concurrency rather
+// than tied to runtime.GOMAXPROCS — a single-core machine still benefits from overlapping
+// many in-flight network/DB calls.
+const maxWorkers = 16
+
+// Option configures how NewCtx (and, through it, New and its variants) evaluates a ruleset.
+type Option func(*evalOptions)
+
+type evalOptions struct {
+	failFast bool
+}
+
+// FailFast stops evaluating a field's rule chain at its first error, instead of collecting
+// every failure for that field.
+func FailFast(o *evalOptions) {
+	o.failFast = true
+}
+
+// CollectAll evaluates every rule for a field and collects all of its errors. This is the
+// default behavior of New and its variants; it only needs to be passed explicitly to override
+// a FailFast set earlier in the same opts list.
+func CollectAll(o *evalOptions) {
+	o.failFast = false
+}
+
+// NewCtx behaves like New but accepts a context.Context, so a custom Validation that does
+// its own I/O (e.g. a DB-backed uniqueness check) can observe cancellation, and opts that
+// control how a field's rule chain is evaluated (FailFast or CollectAll). ruleSet is
+// variadic, like New and its siblings; opts comes first here only because Go allows a single
+// trailing variadic parameter, so pass nil when there are no options. Rulesets larger than
+// parallelThreshold are evaluated across a bounded pool of maxWorkers goroutines instead of
+// one per rule.
+func NewCtx(ctx context.Context, form url.Values, opts []Option, ruleSet ...Rule) map[string][]string {
+	return NewTypedCtx(ctx, form, DefaultTranslator, opts, ruleSet...).Messages()
+}
+
+// NewTypedCtx behaves like NewCtx but returns ValidationErrors.
+func NewTypedCtx(ctx context.Context, form url.Values, t Translator, opts []Option, ruleSet ...Rule) ValidationErrors {
+	o := &evalOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	verrs := make(ValidationErrors)
+
+	if len(ruleSet) <= parallelThreshold {
+		for _, rule := range ruleSet {
+			if ctx.Err() != nil {
+				break
+			}
+
+			collectTyped(verrs, rule, form, t, o.failFast)
+		}
+	} else {
+		evaluateParallel(ctx, verrs, ruleSet, form, t, o.failFast)
+	}
+
+	for k, v := range verrs {
+		if len(v) == 0 {
+			delete(verrs, k)
+		}
+	}
+
+	return verrs
+}
+
+// evaluateParallel runs ruleSet across a bounded pool of maxWorkers goroutines instead of
+// spawning one per rule. Dispatch stops as soon as ctx is done, so no further rules are
+// handed to a worker once the caller has given up; a worker also skips a rule it already
+// picked up if ctx finished while it was waiting its turn.
+func evaluateParallel(ctx context.Context, verrs ValidationErrors, ruleSet []Rule, form url.Values, t Translator, failFast bool) {
+	workers := maxWorkers
+	if workers > len(ruleSet) {
+		workers = len(ruleSet)
+	}
+
+	jobs := make(chan Rule)
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for rule := range jobs {
+				if ctx.Err() != nil {
+					continue
+				}
+
+				errs := rule.validateTyped(form, t, failFast, form[rule.Field]...)
+				if len(errs) == 0 {
+					continue
+				}
+
+				mutex.Lock()
+				verrs[rule.Field] = append(verrs[rule.Field], errs...)
+				mutex.Unlock()
+			}
+		}()
+	}
+
+dispatch:
+	for _, rule := range ruleSet {
+		select {
+		case <-ctx.Done():
+			break dispatch
+		case jobs <- rule:
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+}
+
+func collectTyped(verrs ValidationErrors, rule Rule, form url.Values, t Translator, failFast bool) {
+	errs := rule.validateTyped(form, t, failFast, form[rule.Field]...)
+	if len(errs) == 0 {
+		return
+	}
+
+	verrs[rule.Field] = append(verrs[rule.Field], errs...)
+}
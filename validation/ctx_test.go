@@ -0,0 +1,127 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func buildForm(n int) (url.Values, []Rule) {
+	form := url.Values{}
+	rules := make([]Rule, n)
+
+	for i := 0; i < n; i++ {
+		field := fmt.Sprintf("field%d", i)
+		form.Set(field, "")
+		rules[i] = Field(field, Required())
+	}
+
+	return form, rules
+}
+
+func TestNewCtxSerialAndParallelParity(t *testing.T) {
+	for _, n := range []int{10, parallelThreshold + 25} {
+		t.Run(fmt.Sprintf("n=%d", n), func(t *testing.T) {
+			form, rules := buildForm(n)
+			errs := NewCtx(context.Background(), form, nil, rules...)
+
+			if len(errs) != n {
+				t.Fatalf("expected %d fields with errors, got %d", n, len(errs))
+			}
+			for i := 0; i < n; i++ {
+				field := fmt.Sprintf("field%d", i)
+				if len(errs[field]) != 1 {
+					t.Errorf("expected one error for %s, got %v", field, errs[field])
+				}
+			}
+		})
+	}
+}
+
+func TestNewCtxFailFastAndCollectAll(t *testing.T) {
+	form := url.Values{"name": {""}}
+	rule := Field("name", Required(), MinLength(3))
+
+	collected := NewCtx(context.Background(), form, nil, rule)
+	if len(collected["name"]) != 2 {
+		t.Fatalf("expected both rules to fail by default, got %v", collected["name"])
+	}
+
+	failFast := NewCtx(context.Background(), form, []Option{FailFast}, rule)
+	if len(failFast["name"]) != 1 {
+		t.Fatalf("expected only the first rule to fail with FailFast, got %v", failFast["name"])
+	}
+
+	collectAll := NewCtx(context.Background(), form, []Option{FailFast, CollectAll}, rule)
+	if len(collectAll["name"]) != 2 {
+		t.Fatalf("expected CollectAll to override an earlier FailFast, got %v", collectAll["name"])
+	}
+}
+
+func TestNewCtxHonorsCancellation(t *testing.T) {
+	form, rules := buildForm(parallelThreshold + 25)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	errs := NewCtx(ctx, form, nil, rules...)
+	if len(errs) != 0 {
+		t.Fatalf("expected no work to be dispatched once ctx is already done, got %d fields", len(errs))
+	}
+}
+
+func TestNewTypedCtxVariadicRuleSet(t *testing.T) {
+	form := url.Values{"a": {""}, "b": {""}}
+	verrs := NewTypedCtx(context.Background(), form, DefaultTranslator, nil,
+		Field("a", Required()),
+		Field("b", Required()),
+	)
+
+	if len(verrs) != 2 {
+		t.Fatalf("expected errors for both fields, got %v", verrs)
+	}
+}
+
+// BenchmarkNewCtxSerial evaluates parallelThreshold slow, I/O-like validators, which NewCtx
+// runs serially; BenchmarkNewCtxParallel evaluates parallelThreshold+50 of them, which NewCtx
+// runs across the worker pool. Comparing their reported ns/op demonstrates the pool's speedup
+// on rulesets above parallelThreshold, even though the parallel ruleset is the larger one.
+func slowValidation(d time.Duration) Validation {
+	return func(form url.Values, values ...string) error {
+		time.Sleep(d)
+		return nil
+	}
+}
+
+func buildSlowRuleSet(n int) (url.Values, []Rule) {
+	form := url.Values{}
+	rules := make([]Rule, n)
+
+	for i := 0; i < n; i++ {
+		field := fmt.Sprintf("field%d", i)
+		form.Set(field, "x")
+		rules[i] = Field(field, slowValidation(time.Millisecond))
+	}
+
+	return form, rules
+}
+
+func BenchmarkNewCtxSerial(b *testing.B) {
+	form, rules := buildSlowRuleSet(parallelThreshold)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		NewCtx(context.Background(), form, nil, rules...)
+	}
+}
+
+func BenchmarkNewCtxParallel(b *testing.B) {
+	form, rules := buildSlowRuleSet(parallelThreshold + 50)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		NewCtx(context.Background(), form, nil, rules...)
+	}
+}
@@ -0,0 +1,107 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FieldError describes one failed Validation on one form field. Rule is the same stable
+// message key a Translator renders (e.g. "min_length", "eq_field"), so callers can branch on
+// rule identity instead of parsing Message.
+type FieldError struct {
+	Field   string
+	Rule    string
+	Message string
+	Params  Params
+	Value   string
+}
+
+// ValidationErrors is the structured result of NewTyped. It implements error so it can be
+// returned directly from a handler, and json.Marshaler so it serializes as
+// {"field": [{"rule": "...", "message": "...", "params": {...}, "value": "..."}]}.
+type ValidationErrors map[string][]FieldError
+
+func (v ValidationErrors) Error() string {
+	fields := make([]string, 0, len(v))
+	for field := range v {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var b strings.Builder
+	for _, field := range fields {
+		for _, fe := range v[field] {
+			if b.Len() > 0 {
+				b.WriteString("; ")
+			}
+			fmt.Fprintf(&b, "%s: %s", field, fe.Message)
+		}
+	}
+
+	return b.String()
+}
+
+type fieldErrorJSON struct {
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+	Params  Params `json:"params,omitempty"`
+	Value   string `json:"value,omitempty"`
+}
+
+// MarshalJSON omits Field from each entry since it is already the surrounding map key.
+func (v ValidationErrors) MarshalJSON() ([]byte, error) {
+	out := make(map[string][]fieldErrorJSON, len(v))
+
+	for field, errs := range v {
+		list := make([]fieldErrorJSON, len(errs))
+		for i, fe := range errs {
+			list[i] = fieldErrorJSON{Rule: fe.Rule, Message: fe.Message, Params: fe.Params, Value: fe.Value}
+		}
+		out[field] = list
+	}
+
+	return json.Marshal(out)
+}
+
+// Messages reduces v to the flat map[string][]string shape New has always returned.
+func (v ValidationErrors) Messages() map[string][]string {
+	out := make(map[string][]string, len(v))
+
+	for field, errs := range v {
+		msgs := make([]string, len(errs))
+		for i, fe := range errs {
+			msgs[i] = fe.Message
+		}
+		out[field] = msgs
+	}
+
+	return out
+}
+
+// fieldErrorFrom builds a FieldError from whatever a Validation returned. Built-in validations
+// return a *validationError, which carries the rule key and params; anything else (e.g. a
+// custom Validation that returns fmt.Errorf directly) is reported under the "custom" rule.
+func fieldErrorFrom(err error, t Translator, field string, values []string) FieldError {
+	var ve *validationError
+	if errors.As(err, &ve) {
+		msg := ve.override
+		if msg == "" {
+			msg = t.Translate(ve.key, ve.params)
+		}
+
+		return FieldError{Field: field, Rule: ve.key, Message: msg, Params: ve.params, Value: fieldErrorValue(ve.params, values)}
+	}
+
+	return FieldError{Field: field, Rule: "custom", Message: err.Error(), Value: fieldErrorValue(nil, values)}
+}
+
+func fieldErrorValue(params Params, values []string) string {
+	if v, ok := params["value"]; ok {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return strings.Join(values, ",")
+}
@@ -0,0 +1,77 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"testing"
+)
+
+func TestValidationErrorsError(t *testing.T) {
+	form := url.Values{"name": {""}, "age": {"abc"}}
+	verrs := NewTyped(form,
+		Field("name", Required()),
+		Field("age", GreaterThan(0)),
+	)
+
+	got := verrs.Error()
+	want := "age: is not a number; name: This field is required."
+	if got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestValidationErrorsMarshalJSON(t *testing.T) {
+	form := url.Values{"name": {""}}
+	verrs := NewTyped(form, Field("name", Required()))
+
+	data, err := json.Marshal(verrs)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	var out map[string][]map[string]any
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	entries, ok := out["name"]
+	if !ok || len(entries) != 1 {
+		t.Fatalf("expected one entry under \"name\", got %v", out)
+	}
+	if _, hasField := entries[0]["field"]; hasField {
+		t.Error("expected MarshalJSON to omit the redundant \"field\" key")
+	}
+	if entries[0]["rule"] != "required" {
+		t.Errorf("rule = %v, want \"required\"", entries[0]["rule"])
+	}
+}
+
+func TestValidationErrorsMessages(t *testing.T) {
+	form := url.Values{"name": {""}}
+	verrs := NewTyped(form, Field("name", Required()))
+
+	msgs := verrs.Messages()
+	if len(msgs["name"]) != 1 || msgs["name"][0] != "This field is required." {
+		t.Errorf("Messages()[\"name\"] = %v, want [This field is required.]", msgs["name"])
+	}
+}
+
+func TestFieldErrorFromCustomValidation(t *testing.T) {
+	form := url.Values{"slug": {"bad slug"}}
+	custom := func(form url.Values, values ...string) error {
+		return errors.New("must be a slug")
+	}
+
+	verrs := NewTyped(form, Field("slug", custom))
+	errs := verrs["slug"]
+	if len(errs) != 1 {
+		t.Fatalf("expected one error, got %v", errs)
+	}
+	if errs[0].Rule != "custom" {
+		t.Errorf("Rule = %q, want \"custom\"", errs[0].Rule)
+	}
+	if errs[0].Value != "bad slug" {
+		t.Errorf("Value = %q, want \"bad slug\"", errs[0].Value)
+	}
+}
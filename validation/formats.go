@@ -0,0 +1,295 @@
+package validation
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strconv"
+	"sync"
+	"unicode"
+)
+
+var (
+	formatsMutex sync.RWMutex
+	formats      = map[string]func(string) bool{
+		"email":                isEmail,
+		"url":                  isURL,
+		"ip":                   isIP,
+		"ipv4":                 isIPv4,
+		"ipv6":                 isIPv6,
+		"cidr":                 isCIDR,
+		"mac":                  isMAC,
+		"hostname":             isHostname,
+		"alpha":                isAlpha,
+		"alphanumeric":         isAlphanumeric,
+		"alphanumeric_unicode": isAlphanumericUnicode,
+		"numeric":              isNumeric,
+		"hexadecimal":          isHexadecimal,
+		"base64":               isBase64,
+		"json":                 isJSON,
+		"credit_card":          isCreditCard,
+		"iso3166_alpha2":       isISO3166Alpha2,
+		"iso4217":              isISO4217,
+		"e164":                 isE164,
+	}
+)
+
+// formatLabels gives every built-in format a human-readable name for the English translator's
+// generic "'%v' is not a valid %s." message (the French translator uses frenchFormatLabels).
+// A format added with RegisterFormat has no label, so its failure message is the raw key.
+var formatLabels = map[string]string{
+	"email":                "email address",
+	"url":                  "URL",
+	"ip":                   "IP address",
+	"ipv4":                 "IPv4 address",
+	"ipv6":                 "IPv6 address",
+	"cidr":                 "CIDR block",
+	"mac":                  "MAC address",
+	"hostname":             "hostname",
+	"alpha":                "alphabetic value",
+	"alphanumeric":         "alphanumeric value",
+	"alphanumeric_unicode": "alphanumeric value",
+	"numeric":              "number",
+	"hexadecimal":          "hexadecimal value",
+	"base64":               "base64-encoded value",
+	"json":                 "JSON document",
+	"credit_card":          "credit card number",
+	"iso3166_alpha2":       "ISO 3166-1 alpha-2 country code",
+	"iso4217":              "ISO 4217 currency code",
+	"e164":                 "E.164 phone number",
+}
+
+// RegisterFormat makes a custom format check available under name, both as a standalone
+// Validation via Format(name, ...) and as a `validate` struct tag token.
+func RegisterFormat(name string, fn func(string) bool) {
+	formatsMutex.Lock()
+	defer formatsMutex.Unlock()
+
+	formats[name] = fn
+}
+
+func lookupFormat(name string) (func(string) bool, bool) {
+	formatsMutex.RLock()
+	defer formatsMutex.RUnlock()
+
+	fn, ok := formats[name]
+	return fn, ok
+}
+
+// Format function validates the form field values against a named format, built-in
+// (e.g. "email", "ipv4") or registered with RegisterFormat.
+func Format(name string, message ...string) Validation {
+	return func(form url.Values, values ...string) error {
+		fn, ok := lookupFormat(name)
+		if !ok {
+			return newError("unknown_format", Params{"format": name}, message...)
+		}
+
+		for _, val := range values {
+			if fn(val) {
+				continue
+			}
+
+			return newError(name, Params{"value": val}, message...)
+		}
+
+		return nil
+	}
+}
+
+// Email function validates that the values are syntactically valid email addresses. It only
+// checks RFC 5322 syntax (via net/mail) — it does not look up MX records.
+func Email(message ...string) Validation { return Format("email", message...) }
+
+// URL function validates that the values are absolute URLs with a scheme and a host.
+func URL(message ...string) Validation { return Format("url", message...) }
+
+// IP function validates that the values are IPv4 or IPv6 addresses.
+func IP(message ...string) Validation { return Format("ip", message...) }
+
+// IPv4 function validates that the values are IPv4 addresses.
+func IPv4(message ...string) Validation { return Format("ipv4", message...) }
+
+// IPv6 function validates that the values are IPv6 addresses.
+func IPv6(message ...string) Validation { return Format("ipv6", message...) }
+
+// CIDR function validates that the values are CIDR notation IP address blocks, e.g. "10.0.0.0/8".
+func CIDR(message ...string) Validation { return Format("cidr", message...) }
+
+// MAC function validates that the values are IEEE 802 MAC addresses.
+func MAC(message ...string) Validation { return Format("mac", message...) }
+
+// Hostname function validates that the values are RFC 1123 hostnames.
+func Hostname(message ...string) Validation { return Format("hostname", message...) }
+
+// Alpha function validates that the values contain only ASCII letters.
+func Alpha(message ...string) Validation { return Format("alpha", message...) }
+
+// Alphanumeric function validates that the values contain only ASCII letters and digits.
+func Alphanumeric(message ...string) Validation { return Format("alphanumeric", message...) }
+
+// AlphanumericUnicode function validates that the values contain only Unicode letters and digits.
+func AlphanumericUnicode(message ...string) Validation {
+	return Format("alphanumeric_unicode", message...)
+}
+
+// Numeric function validates that the values are base-10 integers or decimals, optionally signed.
+func Numeric(message ...string) Validation { return Format("numeric", message...) }
+
+// Hexadecimal function validates that the values are hexadecimal strings.
+func Hexadecimal(message ...string) Validation { return Format("hexadecimal", message...) }
+
+// Base64 function validates that the values are standard base64-encoded strings.
+func Base64(message ...string) Validation { return Format("base64", message...) }
+
+// JSON function validates that the values are syntactically valid JSON documents.
+func JSON(message ...string) Validation { return Format("json", message...) }
+
+// CreditCard function validates that the values are credit card numbers that pass the Luhn check.
+func CreditCard(message ...string) Validation { return Format("credit_card", message...) }
+
+// ISO3166Alpha2 function validates that the values are ISO 3166-1 alpha-2 country codes.
+func ISO3166Alpha2(message ...string) Validation { return Format("iso3166_alpha2", message...) }
+
+// ISO4217 function validates that the values are ISO 4217 currency codes.
+func ISO4217(message ...string) Validation { return Format("iso4217", message...) }
+
+// E164 function validates that the values are E.164 phone numbers, e.g. "+14155552671".
+func E164(message ...string) Validation { return Format("e164", message...) }
+
+func isEmail(val string) bool {
+	addr, err := mail.ParseAddress(val)
+	return err == nil && addr.Address == val
+}
+
+func isURL(val string) bool {
+	u, err := url.ParseRequestURI(val)
+	return err == nil && u.Scheme != "" && u.Host != ""
+}
+
+func isIP(val string) bool {
+	return net.ParseIP(val) != nil
+}
+
+func isIPv4(val string) bool {
+	ip := net.ParseIP(val)
+	return ip != nil && ip.To4() != nil
+}
+
+func isIPv6(val string) bool {
+	ip := net.ParseIP(val)
+	return ip != nil && ip.To4() == nil
+}
+
+func isCIDR(val string) bool {
+	_, _, err := net.ParseCIDR(val)
+	return err == nil
+}
+
+func isMAC(val string) bool {
+	_, err := net.ParseMAC(val)
+	return err == nil
+}
+
+var hostnameRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`)
+
+func isHostname(val string) bool {
+	return len(val) <= 253 && hostnameRegex.MatchString(val)
+}
+
+var alphaRegex = regexp.MustCompile(`^[a-zA-Z]+$`)
+
+func isAlpha(val string) bool {
+	return alphaRegex.MatchString(val)
+}
+
+var alphanumericRegex = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+
+func isAlphanumeric(val string) bool {
+	return alphanumericRegex.MatchString(val)
+}
+
+func isAlphanumericUnicode(val string) bool {
+	if val == "" {
+		return false
+	}
+
+	for _, r := range val {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+var numericRegex = regexp.MustCompile(`^[-+]?\d+(\.\d+)?$`)
+
+func isNumeric(val string) bool {
+	return numericRegex.MatchString(val)
+}
+
+var hexadecimalRegex = regexp.MustCompile(`^[0-9a-fA-F]+$`)
+
+func isHexadecimal(val string) bool {
+	return hexadecimalRegex.MatchString(val)
+}
+
+func isBase64(val string) bool {
+	if val == "" {
+		return false
+	}
+
+	_, err := base64.StdEncoding.DecodeString(val)
+	return err == nil
+}
+
+func isJSON(val string) bool {
+	return json.Valid([]byte(val))
+}
+
+// isCreditCard reports whether val is a string of 12-19 digits that passes the Luhn checksum.
+func isCreditCard(val string) bool {
+	if len(val) < 12 || len(val) > 19 {
+		return false
+	}
+
+	sum := 0
+	double := false
+
+	for i := len(val) - 1; i >= 0; i-- {
+		d, err := strconv.Atoi(string(val[i]))
+		if err != nil {
+			return false
+		}
+
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+
+		sum += d
+		double = !double
+	}
+
+	return sum%10 == 0
+}
+
+func isE164(val string) bool {
+	return e164Regex.MatchString(val)
+}
+
+var e164Regex = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+
+func isISO3166Alpha2(val string) bool {
+	return iso3166Alpha2Codes[val]
+}
+
+func isISO4217(val string) bool {
+	return iso4217Codes[val]
+}
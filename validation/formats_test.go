@@ -0,0 +1,80 @@
+package validation
+
+import "testing"
+
+func TestFormatPredicates(t *testing.T) {
+	tests := []struct {
+		name  string
+		fn    func(string) bool
+		valid []string
+		bad   []string
+	}{
+		{"email", isEmail, []string{"a@b.com"}, []string{"", "not-an-email", "a@"}},
+		{"url", isURL, []string{"https://example.com/path"}, []string{"", "example.com", "/just/a/path"}},
+		{"ip", isIP, []string{"127.0.0.1", "::1"}, []string{"", "999.1.1.1"}},
+		{"ipv4", isIPv4, []string{"127.0.0.1"}, []string{"", "::1"}},
+		{"ipv6", isIPv6, []string{"::1"}, []string{"", "127.0.0.1"}},
+		{"cidr", isCIDR, []string{"10.0.0.0/8"}, []string{"", "10.0.0.0"}},
+		{"mac", isMAC, []string{"01:23:45:67:89:ab"}, []string{"", "not-a-mac"}},
+		{"hostname", isHostname, []string{"example.com", "a"}, []string{"", "-bad.example.com"}},
+		{"alpha", isAlpha, []string{"abcXYZ"}, []string{"", "abc123"}},
+		{"alphanumeric", isAlphanumeric, []string{"abc123"}, []string{"", "abc-123"}},
+		{"alphanumeric_unicode", isAlphanumericUnicode, []string{"café123"}, []string{"", "café-123"}},
+		{"numeric", isNumeric, []string{"123", "-1.5"}, []string{"", "abc"}},
+		{"hexadecimal", isHexadecimal, []string{"deadBEEF"}, []string{"", "ghij"}},
+		{"base64", isBase64, []string{"aGVsbG8="}, []string{"", "not base64!"}},
+		{"json", isJSON, []string{`{"a":1}`}, []string{"", "{not json}"}},
+		{"credit_card", isCreditCard, []string{"4111111111111111"}, []string{"", "4111111111111112"}},
+		{"iso3166_alpha2", isISO3166Alpha2, []string{"US", "FR"}, []string{"", "ZZ"}},
+		{"iso4217", isISO4217, []string{"USD", "EUR"}, []string{"", "ZZZ"}},
+		{"e164", isE164, []string{"+14155552671"}, []string{"", "14155552671"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, v := range tt.valid {
+				if !tt.fn(v) {
+					t.Errorf("%s(%q) = false, want true", tt.name, v)
+				}
+			}
+			for _, v := range tt.bad {
+				if tt.fn(v) {
+					t.Errorf("%s(%q) = true, want false", tt.name, v)
+				}
+			}
+		})
+	}
+}
+
+// TestIsBase64RejectsEmptyString pins the empty-string behavior explicitly: an absent value
+// is not itself a valid base64 string, and callers who want to allow one should gate the
+// field with a separate required/omitempty rule.
+func TestIsBase64RejectsEmptyString(t *testing.T) {
+	if isBase64("") {
+		t.Fatal("isBase64(\"\") = true, want false")
+	}
+}
+
+func TestFormatValidation(t *testing.T) {
+	if err := Email()(nil, "not-an-email"); err == nil {
+		t.Fatal("expected Email() to reject an invalid address")
+	}
+	if err := Email()(nil, "a@b.com"); err != nil {
+		t.Fatalf("expected Email() to accept a@b.com, got %v", err)
+	}
+
+	if err := Format("nonexistent-format")(nil, "x"); err == nil {
+		t.Fatal("expected Format with an unregistered name to error")
+	}
+}
+
+func TestRegisterFormat(t *testing.T) {
+	RegisterFormat("even_length", func(val string) bool { return len(val)%2 == 0 })
+
+	if err := Format("even_length")(nil, "ab"); err != nil {
+		t.Fatalf("expected \"ab\" to satisfy even_length, got %v", err)
+	}
+	if err := Format("even_length")(nil, "abc"); err == nil {
+		t.Fatal("expected \"abc\" to fail even_length")
+	}
+}
@@ -0,0 +1,106 @@
+package validation
+
+import "fmt"
+
+// frenchTranslator is a reference implementation showing how to plug in a new locale: register
+// it with RegisterTranslator("fr", ...) (done in init below) or build your own the same way for
+// other locales such as "es" or "pt".
+type frenchTranslator struct{}
+
+func init() {
+	RegisterTranslator("fr", frenchTranslator{})
+}
+
+func (frenchTranslator) Translate(key string, params Params) string {
+	switch key {
+	case "required":
+		return "Ce champ est requis."
+	case "match":
+		return fmt.Sprintf("'%v' ne correspond pas à '%v'.", params["value"], params["match"])
+	case "match_regex":
+		return fmt.Sprintf("'%v' ne correspond pas au format attendu.", params["value"])
+	case "not_a_number":
+		return "n'est pas un nombre"
+	case "less_than":
+		return fmt.Sprintf("%v doit être inférieur à %v.", params["value"], params["bound"])
+	case "less_than_or_equal":
+		return fmt.Sprintf("%v doit être inférieur ou égal à %v.", params["value"], params["bound"])
+	case "greater_than":
+		return fmt.Sprintf("%v doit être supérieur à %v.", params["value"], params["bound"])
+	case "greater_than_or_equal":
+		return fmt.Sprintf("%v doit être supérieur ou égal à %v.", params["value"], params["bound"])
+	case "min_length":
+		return fmt.Sprintf("'%v' ne doit pas contenir moins de %s.", params["value"], frenchCount(params["min"], "caractère"))
+	case "max_length":
+		return fmt.Sprintf("'%v' ne doit pas dépasser %s.", params["value"], frenchCount(params["max"], "caractère"))
+	case "within":
+		return fmt.Sprintf("'%v' ne fait pas partie des options.", params["value"])
+	case "uuid":
+		return fmt.Sprintf("'%v' n'est pas un uuid valide.", params["value"])
+	case "not_a_time":
+		return "n'est pas une date"
+	case "time_equal":
+		return fmt.Sprintf("La date doit être égale à '%s'.", formatBound(params["bound"]))
+	case "time_before":
+		return fmt.Sprintf("La date doit être antérieure à '%s'.", formatBound(params["bound"]))
+	case "time_before_or_equal":
+		return fmt.Sprintf("La date doit être antérieure ou égale à '%s'.", formatBound(params["bound"]))
+	case "time_after":
+		return fmt.Sprintf("La date doit être postérieure à '%s'.", formatBound(params["bound"]))
+	case "time_after_or_equal":
+		return fmt.Sprintf("La date doit être postérieure ou égale à '%s'.", formatBound(params["bound"]))
+	case "eq_field":
+		return fmt.Sprintf("'%v' doit être égal au champ '%v'.", params["value"], params["other"])
+	case "ne_field":
+		return fmt.Sprintf("'%v' ne doit pas être égal au champ '%v'.", params["value"], params["other"])
+	case "gt_field":
+		return fmt.Sprintf("%v doit être supérieur au champ '%v'.", params["value"], params["other"])
+	case "lt_field":
+		return fmt.Sprintf("%v doit être inférieur au champ '%v'.", params["value"], params["other"])
+	case "excluded_if":
+		return fmt.Sprintf("Ce champ n'est pas autorisé quand '%v' vaut '%v'.", params["other"], params["otherValue"])
+	case "excluded_unless":
+		return fmt.Sprintf("Ce champ n'est autorisé que quand '%v' vaut '%v'.", params["other"], params["otherValue"])
+	case "unknown_format":
+		return fmt.Sprintf("'%v' n'est pas un format reconnu.", params["format"])
+	default:
+		if label, ok := frenchFormatLabels[key]; ok {
+			return fmt.Sprintf("'%v' n'est pas %s valide.", params["value"], label)
+		}
+		return key
+	}
+}
+
+// frenchFormatLabels mirrors formatLabels for the French translator's generic
+// "'%v' n'est pas %s valide." message.
+var frenchFormatLabels = map[string]string{
+	"email":                "une adresse e-mail",
+	"url":                  "une URL",
+	"ip":                   "une adresse IP",
+	"ipv4":                 "une adresse IPv4",
+	"ipv6":                 "une adresse IPv6",
+	"cidr":                 "un bloc CIDR",
+	"mac":                  "une adresse MAC",
+	"hostname":             "un nom d'hôte",
+	"alpha":                "une valeur alphabétique",
+	"alphanumeric":         "une valeur alphanumérique",
+	"alphanumeric_unicode": "une valeur alphanumérique",
+	"numeric":              "un nombre",
+	"hexadecimal":          "une valeur hexadécimale",
+	"base64":               "une valeur encodée en base64",
+	"json":                 "un document JSON",
+	"credit_card":          "un numéro de carte bancaire",
+	"iso3166_alpha2":       "un code pays ISO 3166-1 alpha-2",
+	"iso4217":              "un code devise ISO 4217",
+	"e164":                 "un numéro de téléphone E.164",
+}
+
+// frenchCount applies French pluralization (0 and 1 are singular, everything else is plural).
+func frenchCount(n any, noun string) string {
+	count, ok := n.(int)
+	if !ok || count == 0 || count == 1 {
+		return fmt.Sprintf("%v %s", n, noun)
+	}
+
+	return fmt.Sprintf("%v %ss", n, noun)
+}
@@ -0,0 +1,34 @@
+package validation
+
+import "net/url"
+
+// Rule binds a chain of Validation functions to a single form field so that
+// New knows which values to feed them and where to file the resulting
+// error messages.
+type Rule struct {
+	Field       string
+	validations []Validation
+}
+
+// Field declares the Validation chain that must be satisfied by the named
+// form field. By default all validations are evaluated and their errors collected; with the
+// FailFast option, evaluation stops at the first failing validation.
+func Field(field string, validations ...Validation) Rule {
+	return Rule{Field: field, validations: validations}
+}
+
+func (r Rule) validateTyped(form url.Values, t Translator, failFast bool, values ...string) []FieldError {
+	var errs []FieldError
+
+	for _, v := range r.validations {
+		if err := v(form, values...); err != nil {
+			errs = append(errs, fieldErrorFrom(err, t, r.Field, values))
+
+			if failFast {
+				break
+			}
+		}
+	}
+
+	return errs
+}
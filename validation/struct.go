@@ -0,0 +1,356 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// structTag is the struct tag read by Struct to discover the validations
+// that apply to a field.
+const structTag = "validate"
+
+// diveToken marks a slice/map field whose elements should be walked
+// individually instead of being validated as a single value.
+const diveToken = "dive"
+
+// ruleBuilder turns the arguments that followed a rule name in a `validate`
+// tag (e.g. the "3" in "min=3") into a Validation.
+type ruleBuilder func(args ...string) Validation
+
+var (
+	customRulesMutex sync.RWMutex
+	customRules      = make(map[string]ruleBuilder)
+)
+
+// RegisterRule makes a custom rule available under name to every `validate`
+// tag, in addition to the built-in ones (required, min, max, gt, gte, lt,
+// lte, within, uuid, time_before, time_after, match, match_regex). build
+// receives the raw, space-separated arguments that followed "name=" in the
+// tag.
+func RegisterRule(name string, build func(args ...string) Validation) {
+	customRulesMutex.Lock()
+	defer customRulesMutex.Unlock()
+
+	customRules[name] = build
+}
+
+// Struct validates v — a struct or a pointer to one — by reading `validate`
+// tags off its exported fields and running the same Validation functions
+// New uses. Rules within a tag are separated by "|" or "," (e.g.
+// "required|min=3|max=255|match_regex=^[a-z]+$"); a rule's own argument may
+// freely contain either character as long as it's balanced inside (), [] or
+// {}, so a regexp's alternation ("^(a|b)$") or quantifier ("{2,4}") is safe.
+// Nested structs are walked recursively, and a `dive` token in the tag walks
+// the elements of a slice or map field instead of the field itself. Errors
+// are keyed by the dotted field path, e.g. "Address.Street" or "Tags[0]".
+// A tag that names an unknown rule, or gives a rule a malformed argument, is
+// a programmer error in the struct definition rather than a data validation
+// failure — Struct panics on it instead of reporting it as a field error.
+func Struct(v any) map[string][]string {
+	verrs := make(map[string][]string)
+	walkStruct(reflect.ValueOf(v), "", verrs)
+
+	for k, v := range verrs {
+		if len(v) == 0 {
+			delete(verrs, k)
+		}
+	}
+
+	return verrs
+}
+
+func walkStruct(rv reflect.Value, prefix string, verrs map[string][]string) {
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return
+		}
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return
+	}
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		path := field.Name
+		if prefix != "" {
+			path = prefix + "." + field.Name
+		}
+
+		fv := rv.Field(i)
+		preTokens, diveTokens, hasDive := splitDive(splitTag(field.Tag.Get(structTag)))
+
+		for _, errs := range validateTokens(preTokens, fv) {
+			verrs[path] = append(verrs[path], errs)
+		}
+
+		if hasDive {
+			diveInto(fv, path, diveTokens, verrs)
+			continue
+		}
+
+		switch fv.Kind() {
+		case reflect.Struct:
+			walkStruct(fv, path, verrs)
+		case reflect.Pointer:
+			if fv.Elem().Kind() == reflect.Struct {
+				walkStruct(fv, path, verrs)
+			}
+		}
+	}
+}
+
+// splitDive splits tokens at the first "dive" token, so its caller can apply the tokens
+// before dive to the field itself (e.g. "required" on the slice) and the tokens after dive
+// to each of the field's elements.
+func splitDive(tokens []string) (pre, post []string, hasDive bool) {
+	for i, token := range tokens {
+		if token == diveToken {
+			return tokens[:i], tokens[i+1:], true
+		}
+	}
+
+	return tokens, nil, false
+}
+
+// diveInto walks a slice or map field element by element: a struct element recurses through
+// walkStruct and is checked against its own fields' tags only (elemTokens are not applied to
+// it), while a scalar element has elemTokens applied to it directly, the same way a plain
+// field's tokens are. A nil pointer element is skipped.
+func diveInto(fv reflect.Value, path string, elemTokens []string, verrs map[string][]string) {
+	for fv.Kind() == reflect.Pointer {
+		if fv.IsNil() {
+			return
+		}
+		fv = fv.Elem()
+	}
+
+	switch fv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < fv.Len(); i++ {
+			diveElement(fv.Index(i), fmt.Sprintf("%s[%d]", path, i), elemTokens, verrs)
+		}
+	case reflect.Map:
+		for _, key := range fv.MapKeys() {
+			diveElement(fv.MapIndex(key), fmt.Sprintf("%s[%v]", path, key.Interface()), elemTokens, verrs)
+		}
+	}
+}
+
+func diveElement(ev reflect.Value, path string, elemTokens []string, verrs map[string][]string) {
+	for ev.Kind() == reflect.Pointer {
+		if ev.IsNil() {
+			return
+		}
+		ev = ev.Elem()
+	}
+
+	if ev.Kind() == reflect.Struct {
+		walkStruct(ev, path, verrs)
+		return
+	}
+
+	for _, errs := range validateTokens(elemTokens, ev) {
+		verrs[path] = append(verrs[path], errs)
+	}
+}
+
+// validateTokens runs tokens against fv's value and returns the resulting validation
+// messages. A token that doesn't resolve to a Validation — an unknown rule name, or a
+// malformed argument like a bad regexp or a non-numeric min/max — is a bug in the struct
+// definition, not a validation failure of fv's value, so it panics instead of being folded
+// into the returned messages; see resolveRule.
+func validateTokens(tokens []string, fv reflect.Value) []string {
+	var errs []string
+	value := stringify(fv)
+
+	for _, token := range tokens {
+		name, rawArgs := parseRuleToken(token)
+
+		v, err := resolveRule(name, rawArgs, fv.Kind())
+		if err != nil {
+			panic(err)
+		}
+
+		if err := v(nil, value); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	return errs
+}
+
+// splitTag splits a `validate` tag into its rule tokens on "|" or ",", tracking
+// (), [] and {} nesting so a separator inside a rule's own argument — a match_regex
+// alternation like "^(a|b)$", a character class, or a "{n,m}" quantifier — doesn't split
+// the token apart. Only a "|" or "," at depth 0, between rules, ends a token.
+func splitTag(tag string) []string {
+	if tag == "" || tag == "-" {
+		return nil
+	}
+
+	var tokens []string
+	var depth int
+	start := 0
+
+	flush := func(end int) {
+		if part := strings.TrimSpace(tag[start:end]); part != "" {
+			tokens = append(tokens, part)
+		}
+		start = end + 1
+	}
+
+	for i, r := range tag {
+		switch r {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			if depth > 0 {
+				depth--
+			}
+		case '|', ',':
+			if depth == 0 {
+				flush(i)
+			}
+		}
+	}
+	flush(len(tag))
+
+	return tokens
+}
+
+func parseRuleToken(token string) (name, rawArgs string) {
+	if i := strings.IndexByte(token, '='); i >= 0 {
+		return strings.TrimSpace(token[:i]), token[i+1:]
+	}
+
+	return strings.TrimSpace(token), ""
+}
+
+func resolveRule(name, rawArgs string, kind reflect.Kind) (Validation, error) {
+	customRulesMutex.RLock()
+	build, ok := customRules[name]
+	customRulesMutex.RUnlock()
+
+	if ok {
+		return build(strings.Fields(rawArgs)...), nil
+	}
+
+	return builtinRule(name, rawArgs, kind)
+}
+
+func builtinRule(name, rawArgs string, kind reflect.Kind) (Validation, error) {
+	switch name {
+	case "required":
+		return Required(), nil
+	case "min":
+		if isNumericKind(kind) {
+			return numericRule(GreaterThanOrEqualTo, rawArgs)
+		}
+		n, err := strconv.Atoi(rawArgs)
+		if err != nil {
+			return nil, fmt.Errorf("validation: invalid min argument %q", rawArgs)
+		}
+		return MinLength(n), nil
+	case "max":
+		if isNumericKind(kind) {
+			return numericRule(LessThanOrEqualTo, rawArgs)
+		}
+		n, err := strconv.Atoi(rawArgs)
+		if err != nil {
+			return nil, fmt.Errorf("validation: invalid max argument %q", rawArgs)
+		}
+		return MaxLength(n), nil
+	case "gt":
+		return numericRule(GreaterThan, rawArgs)
+	case "gte":
+		return numericRule(GreaterThanOrEqualTo, rawArgs)
+	case "lt":
+		return numericRule(LessThan, rawArgs)
+	case "lte":
+		return numericRule(LessThanOrEqualTo, rawArgs)
+	case "within":
+		return WithinOptions(strings.Fields(rawArgs)), nil
+	case "uuid":
+		return ValidUUID(), nil
+	case "time_before":
+		return timeRule(TimeBefore, rawArgs)
+	case "time_after":
+		return timeRule(TimeAfter, rawArgs)
+	case "match":
+		return Match(rawArgs), nil
+	case "match_regex":
+		re, err := regexp.Compile(rawArgs)
+		if err != nil {
+			return nil, fmt.Errorf("validation: invalid match_regex argument %q: %w", rawArgs, err)
+		}
+		return MatchRegex(re), nil
+	default:
+		if _, ok := lookupFormat(name); ok {
+			return Format(name), nil
+		}
+		return nil, fmt.Errorf("validation: unknown rule %q", name)
+	}
+}
+
+func numericRule(build func(float64, ...string) Validation, rawArgs string) (Validation, error) {
+	n, err := strconv.ParseFloat(rawArgs, 64)
+	if err != nil {
+		return nil, fmt.Errorf("validation: invalid numeric argument %q", rawArgs)
+	}
+
+	return build(n), nil
+}
+
+func timeRule(build func(time.Time, ...string) Validation, rawArgs string) (Validation, error) {
+	t, err := parseTime(rawArgs)
+	if err != nil {
+		return nil, fmt.Errorf("validation: invalid time argument %q", rawArgs)
+	}
+
+	return build(t), nil
+}
+
+// isNumericKind reports whether kind is an integer or floating-point Go kind, so min/max can
+// compare a numeric field's value instead of the digit count of its string form.
+func isNumericKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+func stringify(fv reflect.Value) string {
+	switch fv.Kind() {
+	case reflect.String:
+		return fv.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(fv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(fv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(fv.Float(), 'f', -1, 64)
+	case reflect.Bool:
+		return strconv.FormatBool(fv.Bool())
+	default:
+		if t, ok := fv.Interface().(time.Time); ok {
+			return t.Format(time.RFC3339)
+		}
+		return fmt.Sprintf("%v", fv.Interface())
+	}
+}
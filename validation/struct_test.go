@@ -0,0 +1,192 @@
+package validation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitTag(t *testing.T) {
+	tests := []struct {
+		name string
+		tag  string
+		want []string
+	}{
+		{"empty", "", nil},
+		{"dash", "-", nil},
+		{"single", "required", []string{"required"}},
+		{"comma separated", "required,min=3,max=255", []string{"required", "min=3", "max=255"}},
+		{"regex alternation survives", "match_regex=^(a|b)$", []string{"match_regex=^(a|b)$"}},
+		{"regex alternation alongside other rules", "required,match_regex=^(a|b)$", []string{"required", "match_regex=^(a|b)$"}},
+		{"pipe separated rules (headline syntax)", "required|min=3|max=255|match_regex=^[a-z]+$", []string{"required", "min=3", "max=255", "match_regex=^[a-z]+$"}},
+		{"quantifier braces protect their comma", "match_regex=^[a-z]{2,4}$", []string{"match_regex=^[a-z]{2,4}$"}},
+		{"quantifier braces alongside other rules", "required,match_regex=^[a-z]{2,4}$", []string{"required", "match_regex=^[a-z]{2,4}$"}},
+		{"within keeps its space-separated args", "within=a b c", []string{"within=a b c"}},
+		{"stray whitespace trimmed", " required , min=3 ", []string{"required", "min=3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := splitTag(tt.tag)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitTag(%q) = %#v, want %#v", tt.tag, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitDive(t *testing.T) {
+	pre, post, hasDive := splitDive([]string{"required", "dive", "required", "min=3"})
+	if !hasDive {
+		t.Fatal("expected hasDive to be true")
+	}
+	if !reflect.DeepEqual(pre, []string{"required"}) {
+		t.Errorf("pre = %#v, want [required]", pre)
+	}
+	if !reflect.DeepEqual(post, []string{"required", "min=3"}) {
+		t.Errorf("post = %#v, want [required min=3]", post)
+	}
+
+	pre, post, hasDive = splitDive([]string{"required", "min=3"})
+	if hasDive {
+		t.Fatal("expected hasDive to be false")
+	}
+	if !reflect.DeepEqual(pre, []string{"required", "min=3"}) {
+		t.Errorf("pre = %#v, want [required min=3]", pre)
+	}
+	if post != nil {
+		t.Errorf("post = %#v, want nil", post)
+	}
+}
+
+func TestStruct(t *testing.T) {
+	type Address struct {
+		City string `validate:"required"`
+	}
+
+	type Form struct {
+		Name    string `validate:"required,min=3"`
+		Age     int    `validate:"min=18,max=130"`
+		Address Address
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		f := Form{Name: "Alice", Age: 30, Address: Address{City: "Paris"}}
+		if errs := Struct(f); len(errs) != 0 {
+			t.Fatalf("expected no errors, got %v", errs)
+		}
+	})
+
+	t.Run("nested struct path", func(t *testing.T) {
+		f := Form{Name: "Alice", Age: 30, Address: Address{}}
+		errs := Struct(f)
+		if _, ok := errs["Address.City"]; !ok {
+			t.Fatalf("expected error keyed at Address.City, got %v", errs)
+		}
+	})
+
+	t.Run("min/max are numeric bounds on numeric fields", func(t *testing.T) {
+		f := Form{Name: "Alice", Age: 7, Address: Address{City: "Paris"}}
+		errs := Struct(f)
+		got := errs["Age"]
+		if len(got) != 1 {
+			t.Fatalf("expected one error for Age, got %v", got)
+		}
+		if got[0] != "7 must be greater than or equal to 18." {
+			t.Errorf("unexpected message for numeric min: %q", got[0])
+		}
+	})
+}
+
+func TestStructDive(t *testing.T) {
+	type Tagged struct {
+		Tags []string `validate:"dive,required,min=3"`
+	}
+
+	t.Run("element rules are applied per element", func(t *testing.T) {
+		errs := Struct(Tagged{Tags: []string{"abc", ""}})
+		if _, ok := errs["Tags[1]"]; !ok {
+			t.Fatalf("expected an error on Tags[1], got %v", errs)
+		}
+		if _, ok := errs["Tags[0]"]; ok {
+			t.Fatalf("did not expect an error on Tags[0], got %v", errs)
+		}
+	})
+
+	t.Run("empty slice has no elements to fail", func(t *testing.T) {
+		errs := Struct(Tagged{})
+		if len(errs) != 0 {
+			t.Fatalf("expected no errors for an empty slice, got %v", errs)
+		}
+	})
+
+	type Nested struct {
+		City string `validate:"required"`
+	}
+	type NestedSlice struct {
+		Places []Nested `validate:"dive"`
+	}
+
+	t.Run("dive into struct elements still walks their own tags", func(t *testing.T) {
+		errs := Struct(NestedSlice{Places: []Nested{{}, {City: "Rome"}}})
+		if _, ok := errs["Places[0].City"]; !ok {
+			t.Fatalf("expected an error on Places[0].City, got %v", errs)
+		}
+		if _, ok := errs["Places[1].City"]; ok {
+			t.Fatalf("did not expect an error on Places[1].City, got %v", errs)
+		}
+	})
+
+	type MapTagged struct {
+		Scores map[string]int `validate:"dive,min=1"`
+	}
+
+	t.Run("dive walks map elements too", func(t *testing.T) {
+		errs := Struct(MapTagged{Scores: map[string]int{"a": 0, "b": 5}})
+		if _, ok := errs["Scores[a]"]; !ok {
+			t.Fatalf("expected an error on Scores[a], got %v", errs)
+		}
+		if _, ok := errs["Scores[b]"]; ok {
+			t.Fatalf("did not expect an error on Scores[b], got %v", errs)
+		}
+	})
+}
+
+func TestStructUnknownRulePanics(t *testing.T) {
+	type Form struct {
+		Name string `validate:"not_a_real_rule"`
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected Struct to panic on an unresolvable tag")
+		}
+	}()
+
+	Struct(Form{Name: "x"})
+}
+
+func TestStructRegexQuantifier(t *testing.T) {
+	type Form struct {
+		Code string `validate:"match_regex=^[a-z]{2,4}$"`
+	}
+
+	if errs := Struct(Form{Code: "abc"}); len(errs) != 0 {
+		t.Fatalf("expected \"abc\" to satisfy a {2,4} quantifier, got %v", errs)
+	}
+	if errs := Struct(Form{Code: "a"}); len(errs["Code"]) != 1 {
+		t.Fatalf("expected \"a\" to fail a {2,4} quantifier, got %v", errs["Code"])
+	}
+}
+
+func TestStructPipeSeparatedRules(t *testing.T) {
+	type Form struct {
+		Name string `validate:"required|min=3|max=255|match_regex=^[a-z]+$"`
+	}
+
+	if errs := Struct(Form{Name: "alice"}); len(errs) != 0 {
+		t.Fatalf("expected no errors, got %v", errs)
+	}
+	if errs := Struct(Form{Name: ""}); len(errs["Name"]) == 0 {
+		t.Fatal("expected an empty name to fail required")
+	}
+}
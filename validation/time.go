@@ -0,0 +1,173 @@
+package validation
+
+import (
+	"errors"
+	"net/url"
+	"time"
+)
+
+// TimeLayouts is the ordered list of layouts ParseTime and the time validators (TimeBefore,
+// TimeAfter, etc.) try when no explicit layout is given. The first layout that parses wins,
+// so the result depends on the list's order, and a value that carries only a clock time
+// (time.Kitchen, time.TimeOnly) or no year (time.Stamp and its variants) parses to a time in
+// year 0 rather than on any particular day. Pin a layout per field with ParseTime,
+// TimeBeforeIn, or one of its siblings when that matters.
+var TimeLayouts = []string{
+	time.DateOnly,
+	time.Layout,
+	time.ANSIC,
+	time.UnixDate,
+	time.RubyDate,
+	time.RFC822,
+	time.RFC822Z,
+	time.RFC850,
+	time.RFC1123,
+	time.RFC1123Z,
+	time.RFC3339,
+	time.RFC3339Nano,
+	time.Kitchen,
+	time.Stamp,
+	time.StampMilli,
+	time.StampMicro,
+	time.StampNano,
+	time.DateTime,
+	time.TimeOnly,
+}
+
+// ParseTime parses value against layouts in order, returning the first successful result. If
+// layouts is empty, TimeLayouts is used.
+func ParseTime(value string, layouts ...string) (time.Time, error) {
+	return parseTimeIn(value, layouts, nil)
+}
+
+func parseTime(value string) (time.Time, error) {
+	return parseTimeIn(value, nil, nil)
+}
+
+func parseTimeIn(value string, layouts []string, loc *time.Location) (time.Time, error) {
+	if len(layouts) == 0 {
+		layouts = TimeLayouts
+	}
+
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	for _, layout := range layouts {
+		t, err := time.ParseInLocation(layout, value, loc)
+		if err != nil {
+			continue
+		}
+
+		return t, nil
+	}
+
+	return time.Time{}, errors.New("invalid time")
+}
+
+// TimeEqualToIn is like TimeEqualTo but parses values against layouts (or TimeLayouts, if nil)
+// in loc (or time.UTC, if nil) instead of guessing from the full TimeLayouts list.
+func TimeEqualToIn(u time.Time, layouts []string, loc *time.Location, message ...string) Validation {
+	return func(form url.Values, values ...string) error {
+		for _, value := range values {
+			t, err := parseTimeIn(value, layouts, loc)
+			if err != nil {
+				return newError("not_a_time", Params{"value": value}, message...)
+			}
+
+			if t.Equal(u) {
+				continue
+			}
+
+			return newError("time_equal", Params{"value": value, "bound": u}, message...)
+		}
+
+		return nil
+	}
+}
+
+// TimeBeforeIn is like TimeBefore but parses values against layouts (or TimeLayouts, if nil)
+// in loc (or time.UTC, if nil) instead of guessing from the full TimeLayouts list.
+func TimeBeforeIn(u time.Time, layouts []string, loc *time.Location, message ...string) Validation {
+	return func(form url.Values, values ...string) error {
+		for _, value := range values {
+			t, err := parseTimeIn(value, layouts, loc)
+			if err != nil {
+				return newError("not_a_time", Params{"value": value}, message...)
+			}
+
+			if t.Before(u) {
+				continue
+			}
+
+			return newError("time_before", Params{"value": value, "bound": u}, message...)
+		}
+
+		return nil
+	}
+}
+
+// TimeBeforeOrEqualToIn is like TimeBeforeOrEqualTo but parses values against layouts (or
+// TimeLayouts, if nil) in loc (or time.UTC, if nil) instead of guessing from the full
+// TimeLayouts list.
+func TimeBeforeOrEqualToIn(u time.Time, layouts []string, loc *time.Location, message ...string) Validation {
+	return func(form url.Values, values ...string) error {
+		for _, value := range values {
+			t, err := parseTimeIn(value, layouts, loc)
+			if err != nil {
+				return newError("not_a_time", Params{"value": value}, message...)
+			}
+
+			if t.Before(u) || t.Equal(u) {
+				continue
+			}
+
+			return newError("time_before_or_equal", Params{"value": value, "bound": u}, message...)
+		}
+
+		return nil
+	}
+}
+
+// TimeAfterIn is like TimeAfter but parses values against layouts (or TimeLayouts, if nil) in
+// loc (or time.UTC, if nil) instead of guessing from the full TimeLayouts list.
+func TimeAfterIn(u time.Time, layouts []string, loc *time.Location, message ...string) Validation {
+	return func(form url.Values, values ...string) error {
+		for _, val := range values {
+			t, err := parseTimeIn(val, layouts, loc)
+			if err != nil {
+				return newError("not_a_time", Params{"value": val}, message...)
+			}
+
+			if t.After(u) {
+				continue
+			}
+
+			return newError("time_after", Params{"value": val, "bound": u}, message...)
+		}
+
+		return nil
+	}
+}
+
+// TimeAfterOrEqualToIn is like TimeAfterOrEqualTo but parses values against layouts (or
+// TimeLayouts, if nil) in loc (or time.UTC, if nil) instead of guessing from the full
+// TimeLayouts list.
+func TimeAfterOrEqualToIn(u time.Time, layouts []string, loc *time.Location, message ...string) Validation {
+	return func(form url.Values, values ...string) error {
+		for _, val := range values {
+			t, err := parseTimeIn(val, layouts, loc)
+			if err != nil {
+				return newError("not_a_time", Params{"value": val}, message...)
+			}
+
+			if t.After(u) || t.Equal(u) {
+				continue
+			}
+
+			return newError("time_after_or_equal", Params{"value": val, "bound": u}, message...)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,62 @@
+package validation
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTime(t *testing.T) {
+	got, err := ParseTime("2024-01-15")
+	if err != nil {
+		t.Fatalf("ParseTime failed: %v", err)
+	}
+	want := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("ParseTime = %v, want %v", got, want)
+	}
+
+	if _, err := ParseTime("not a time"); err == nil {
+		t.Fatal("expected an error for an unparseable value")
+	}
+}
+
+func TestParseTimeExplicitLayout(t *testing.T) {
+	got, err := ParseTime("15/01/2024", "02/01/2006")
+	if err != nil {
+		t.Fatalf("ParseTime with an explicit layout failed: %v", err)
+	}
+	want := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("ParseTime = %v, want %v", got, want)
+	}
+}
+
+func TestTimeBeforeInLayoutAndZone(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("tzdata unavailable: %v", err)
+	}
+
+	bound := time.Date(2024, 1, 1, 12, 0, 0, 0, loc)
+	layout := "2006-01-02 15:04"
+	v := TimeBeforeIn(bound, []string{layout}, loc)
+
+	if err := v(nil, "2024-01-01 11:00"); err != nil {
+		t.Errorf("expected 11:00 to be before 12:00 in %s, got %v", loc, err)
+	}
+	if err := v(nil, "2024-01-01 13:00"); err == nil {
+		t.Error("expected 13:00 to be after 12:00 in the given zone")
+	}
+}
+
+func TestTimeAfterInDefaultsToUTC(t *testing.T) {
+	bound := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	v := TimeAfterIn(bound, []string{time.DateOnly}, nil)
+
+	if err := v(nil, "2024-02-01"); err != nil {
+		t.Errorf("expected 2024-02-01 to be after the bound, got %v", err)
+	}
+	if err := v(nil, "2023-12-01"); err == nil {
+		t.Error("expected 2023-12-01 to be before the bound")
+	}
+}
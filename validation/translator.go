@@ -0,0 +1,116 @@
+package validation
+
+import (
+	"fmt"
+	"time"
+)
+
+// Params carries the values a Translator needs to render a message key, e.g. {"min": 3,
+// "value": "ab"} for the "min_length" key.
+type Params map[string]any
+
+// Translator renders a built-in Validation's stable message key (e.g. "required",
+// "min_length", "time_before") and its Params into a user-facing string. Register one per
+// locale with RegisterTranslator and pass it to NewWithTranslator.
+type Translator interface {
+	Translate(key string, params Params) string
+}
+
+// DefaultTranslator is used by New, Struct, and any Validation invoked directly when no
+// Translator is supplied. It renders the package's original English messages.
+var DefaultTranslator Translator = englishTranslator{}
+
+var translators = map[string]Translator{
+	"en": DefaultTranslator,
+}
+
+// RegisterTranslator makes t available under locale for callers that look translators up by
+// name (e.g. from an Accept-Language header) instead of wiring a Translator value directly.
+func RegisterTranslator(locale string, t Translator) {
+	translators[locale] = t
+}
+
+// LookupTranslator returns the Translator registered for locale, and false if none was.
+func LookupTranslator(locale string) (Translator, bool) {
+	t, ok := translators[locale]
+	return t, ok
+}
+
+type englishTranslator struct{}
+
+func (englishTranslator) Translate(key string, params Params) string {
+	switch key {
+	case "required":
+		return "This field is required."
+	case "match":
+		return fmt.Sprintf("'%v' does not match with '%v'.", params["value"], params["match"])
+	case "match_regex":
+		return fmt.Sprintf("'%v' does not match the expected pattern.", params["value"])
+	case "not_a_number":
+		return "is not a number"
+	case "less_than":
+		return fmt.Sprintf("%v must be less than %v.", params["value"], params["bound"])
+	case "less_than_or_equal":
+		return fmt.Sprintf("%v must be less than or equal to %v.", params["value"], params["bound"])
+	case "greater_than":
+		return fmt.Sprintf("%v must be greater than %v.", params["value"], params["bound"])
+	case "greater_than_or_equal":
+		return fmt.Sprintf("%v must be greater than or equal to %v.", params["value"], params["bound"])
+	case "min_length":
+		return fmt.Sprintf("'%v' must not be less than %s.", params["value"], englishCount(params["min"], "character"))
+	case "max_length":
+		return fmt.Sprintf("'%v' must not exceed %s.", params["value"], englishCount(params["max"], "character"))
+	case "within":
+		return fmt.Sprintf("'%v' is not in the options.", params["value"])
+	case "uuid":
+		return fmt.Sprintf("'%v' is not a valid uuid.", params["value"])
+	case "not_a_time":
+		return "is not a time"
+	case "time_equal":
+		return fmt.Sprintf("Time should be equal to '%s'.", formatBound(params["bound"]))
+	case "time_before":
+		return fmt.Sprintf("Time should be before than '%s'.", formatBound(params["bound"]))
+	case "time_before_or_equal":
+		return fmt.Sprintf("Time should be before or equal to '%s'.", formatBound(params["bound"]))
+	case "time_after":
+		return fmt.Sprintf("Time should be after '%s'.", formatBound(params["bound"]))
+	case "time_after_or_equal":
+		return fmt.Sprintf("Time should be after or equal to '%s'.", formatBound(params["bound"]))
+	case "eq_field":
+		return fmt.Sprintf("'%v' must be equal to field '%v'.", params["value"], params["other"])
+	case "ne_field":
+		return fmt.Sprintf("'%v' must not be equal to field '%v'.", params["value"], params["other"])
+	case "gt_field":
+		return fmt.Sprintf("%v must be greater than field '%v'.", params["value"], params["other"])
+	case "lt_field":
+		return fmt.Sprintf("%v must be less than field '%v'.", params["value"], params["other"])
+	case "excluded_if":
+		return fmt.Sprintf("This field is not allowed when '%v' is '%v'.", params["other"], params["otherValue"])
+	case "excluded_unless":
+		return fmt.Sprintf("This field is only allowed when '%v' is '%v'.", params["other"], params["otherValue"])
+	case "unknown_format":
+		return fmt.Sprintf("'%v' is not a recognized format.", params["format"])
+	default:
+		if label, ok := formatLabels[key]; ok {
+			return fmt.Sprintf("'%v' is not a valid %s.", params["value"], label)
+		}
+		return key
+	}
+}
+
+// englishCount renders an English noun with its plural ("1 character" vs "3 characters").
+func englishCount(n any, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%v %s", n, noun)
+	}
+
+	return fmt.Sprintf("%v %ss", n, noun)
+}
+
+func formatBound(bound any) string {
+	if t, ok := bound.(time.Time); ok {
+		return t.Format(time.DateOnly)
+	}
+
+	return fmt.Sprintf("%v", bound)
+}
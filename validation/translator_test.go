@@ -0,0 +1,60 @@
+package validation
+
+import "testing"
+
+func TestEnglishTranslatorMessages(t *testing.T) {
+	tests := []struct {
+		key    string
+		params Params
+		want   string
+	}{
+		{"required", nil, "This field is required."},
+		{"min_length", Params{"value": "ab", "min": 3}, "'ab' must not be less than 3 characters."},
+		{"min_length", Params{"value": "a", "min": 1}, "'a' must not be less than 1 character."},
+		{"within", Params{"value": "x"}, "'x' is not in the options."},
+		{"email", Params{"value": "x"}, "'x' is not a valid email address."},
+		{"unknown_key_with_no_label", Params{"value": "x"}, "unknown_key_with_no_label"},
+	}
+
+	for _, tt := range tests {
+		if got := DefaultTranslator.Translate(tt.key, tt.params); got != tt.want {
+			t.Errorf("Translate(%q, %v) = %q, want %q", tt.key, tt.params, got, tt.want)
+		}
+	}
+}
+
+func TestFrenchTranslatorMessages(t *testing.T) {
+	fr, ok := LookupTranslator("fr")
+	if !ok {
+		t.Fatal("expected \"fr\" to be registered by locale_fr.go's init")
+	}
+
+	tests := []struct {
+		key    string
+		params Params
+		want   string
+	}{
+		{"required", nil, "Ce champ est requis."},
+		{"min_length", Params{"value": "ab", "min": 3}, "'ab' ne doit pas contenir moins de 3 caractères."},
+	}
+
+	for _, tt := range tests {
+		if got := fr.Translate(tt.key, tt.params); got != tt.want {
+			t.Errorf("Translate(%q, %v) = %q, want %q", tt.key, tt.params, got, tt.want)
+		}
+	}
+}
+
+func TestRegisterTranslator(t *testing.T) {
+	custom := englishTranslator{}
+	RegisterTranslator("xx-test", custom)
+
+	got, ok := LookupTranslator("xx-test")
+	if !ok || got != Translator(custom) {
+		t.Fatalf("expected the registered translator back, got %v, %v", got, ok)
+	}
+
+	if _, ok := LookupTranslator("not-registered"); ok {
+		t.Fatal("expected no translator for an unregistered locale")
+	}
+}
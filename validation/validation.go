@@ -1,14 +1,12 @@
 package validation
 
 import (
-	"errors"
-	"fmt"
+	"context"
 	"net/url"
 	"regexp"
 	"slices"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/gofrs/uuid/v5"
@@ -16,32 +14,40 @@ import (
 
 // New is the main function in charge of validating the HTTP request form by using the defined rule set.
 // Form fields will be validated only if there is a rule that indicates they must be validated.
+// It is a thin wrapper around NewTyped for callers that only need plain messages; use NewTyped
+// for the rule name and parameters behind each message, or NewCtx to pass a context.Context and
+// evaluation Options.
 func New(form url.Values, ruleSet ...Rule) map[string][]string {
-	verrs := make(map[string][]string)
-	mutex := new(sync.RWMutex)
+	return NewTyped(form, ruleSet...).Messages()
+}
 
-	for _, rule := range ruleSet {
-		mutex.Lock()
-		verrs[rule.Field] = append(verrs[rule.Field], rule.validate(form[rule.Field]...)...)
-		mutex.Unlock()
-	}
+// NewWithTranslator behaves like New but renders every message through t instead of the
+// package's DefaultTranslator, so an HTTP handler can localize errors per request (e.g. based
+// on an Accept-Language header) without changing how rules are declared.
+func NewWithTranslator(form url.Values, t Translator, ruleSet ...Rule) map[string][]string {
+	return NewTypedWithTranslator(form, t, ruleSet...).Messages()
+}
 
-	for k, v := range verrs {
-		if len(v) == 0 {
-			delete(verrs, k)
-		}
-	}
+// NewTyped behaves like New but returns ValidationErrors, preserving each failure's rule name
+// and parameters so a handler can serialize them as JSON or branch on rule identity.
+func NewTyped(form url.Values, ruleSet ...Rule) ValidationErrors {
+	return NewTypedWithTranslator(form, DefaultTranslator, ruleSet...)
+}
 
-	return verrs
+// NewTypedWithTranslator behaves like NewTyped but renders every Message through t.
+func NewTypedWithTranslator(form url.Values, t Translator, ruleSet ...Rule) ValidationErrors {
+	return NewTypedCtx(context.Background(), form, t, nil, ruleSet...)
 }
 
-// Validation is a condition that must be satisfied by all values in a specific form field.
+// Validation is a condition that must be satisfied by all values in a specific form field,
 // or else an error message is displayed indicating that at least one value is invalid.
-type Validation func(...string) error
+// It receives the whole submitted form so that cross-field validations (EqField, RequiredIf,
+// and similar) can look up other fields' values.
+type Validation func(form url.Values, values ...string) error
 
 // Required function validates the form field has no-empty values.
 func Required(message ...string) Validation {
-	return func(values ...string) error {
+	return func(form url.Values, values ...string) error {
 		hasEmptyValues := slices.ContainsFunc(values, func(val string) bool {
 			return strings.TrimSpace(val) == ""
 		})
@@ -50,19 +56,19 @@ func Required(message ...string) Validation {
 			return nil
 		}
 
-		return newError("This field is required.", message...)
+		return newError("required", nil, message...)
 	}
 }
 
 // Match function validates the form field values with a string.
 func Match(value string, message ...string) Validation {
-	return func(values ...string) error {
+	return func(form url.Values, values ...string) error {
 		for _, val := range values {
 			if val == value {
 				continue
 			}
 
-			return newError(fmt.Sprintf("'%s' does not match with '%s'.", val, value), message...)
+			return newError("match", Params{"value": val, "match": value}, message...)
 		}
 
 		return nil
@@ -71,13 +77,13 @@ func Match(value string, message ...string) Validation {
 
 // MatchRegex function validates the form field values with a regular expression.
 func MatchRegex(re *regexp.Regexp, message ...string) Validation {
-	return func(values ...string) error {
+	return func(form url.Values, values ...string) error {
 		for _, val := range values {
 			if re.MatchString(val) {
 				continue
 			}
 
-			return newError("", message...)
+			return newError("match_regex", Params{"value": val, "pattern": re.String()}, message...)
 		}
 
 		return nil
@@ -86,18 +92,18 @@ func MatchRegex(re *regexp.Regexp, message ...string) Validation {
 
 // LessThan function validates that the field values are less than a value.
 func LessThan(value float64, message ...string) Validation {
-	return func(values ...string) error {
+	return func(form url.Values, values ...string) error {
 		for _, val := range values {
 			n, err := strconv.ParseFloat(val, 64)
 			if err != nil {
-				return errors.New("is not a number")
+				return newError("not_a_number", Params{"value": val}, message...)
 			}
 
 			if n < value {
 				continue
 			}
 
-			return newError(fmt.Sprintf("%s must be less than %f.", val, value), message...)
+			return newError("less_than", Params{"value": val, "bound": value}, message...)
 		}
 
 		return nil
@@ -106,18 +112,18 @@ func LessThan(value float64, message ...string) Validation {
 
 // LessThanOrEqualTo function validates that the field values are less than or equal to a value.
 func LessThanOrEqualTo(value float64, message ...string) Validation {
-	return func(values ...string) error {
+	return func(form url.Values, values ...string) error {
 		for _, val := range values {
 			n, err := strconv.ParseFloat(val, 64)
 			if err != nil {
-				return errors.New("is not a number")
+				return newError("not_a_number", Params{"value": val}, message...)
 			}
 
 			if n <= value {
 				continue
 			}
 
-			return newError(fmt.Sprintf("%s must be less than or equal to %f.", val, value), message...)
+			return newError("less_than_or_equal", Params{"value": val, "bound": value}, message...)
 		}
 
 		return nil
@@ -126,18 +132,18 @@ func LessThanOrEqualTo(value float64, message ...string) Validation {
 
 // GreaterThan function validates that the field values are greater than a value.
 func GreaterThan(value float64, message ...string) Validation {
-	return func(values ...string) error {
+	return func(form url.Values, values ...string) error {
 		for _, val := range values {
 			n, err := strconv.ParseFloat(val, 64)
 			if err != nil {
-				return errors.New("is not a number")
+				return newError("not_a_number", Params{"value": val}, message...)
 			}
 
 			if n > value {
 				continue
 			}
 
-			return newError(fmt.Sprintf("%s must be greater than %f.", val, value), message...)
+			return newError("greater_than", Params{"value": val, "bound": value}, message...)
 		}
 
 		return nil
@@ -146,18 +152,18 @@ func GreaterThan(value float64, message ...string) Validation {
 
 // GreaterThanOrEqualTo function validates that the field values are greater than or equal to a value.
 func GreaterThanOrEqualTo(value float64, message ...string) Validation {
-	return func(values ...string) error {
+	return func(form url.Values, values ...string) error {
 		for _, val := range values {
 			n, err := strconv.ParseFloat(val, 64)
 			if err != nil {
-				return errors.New("is not a number")
+				return newError("not_a_number", Params{"value": val}, message...)
 			}
 
 			if n >= value {
 				continue
 			}
 
-			return newError(fmt.Sprintf("%s must be greater than or equal to %f.", val, value), message...)
+			return newError("greater_than_or_equal", Params{"value": val, "bound": value}, message...)
 		}
 
 		return nil
@@ -166,13 +172,13 @@ func GreaterThanOrEqualTo(value float64, message ...string) Validation {
 
 // MinLength function validates that the values' lengths are greater than or equal to min.
 func MinLength(min int, message ...string) Validation {
-	return func(values ...string) error {
+	return func(form url.Values, values ...string) error {
 		for _, val := range values {
 			if len(strings.TrimSpace(val)) >= min {
 				continue
 			}
 
-			return newError(fmt.Sprintf("'%s' must not be less than %d characters.", val, min), message...)
+			return newError("min_length", Params{"value": val, "min": min}, message...)
 		}
 
 		return nil
@@ -181,13 +187,13 @@ func MinLength(min int, message ...string) Validation {
 
 // MaxLength function validates that the values' lengths are less than or equal to max.
 func MaxLength(max int, message ...string) Validation {
-	return func(values ...string) error {
+	return func(form url.Values, values ...string) error {
 		for _, val := range values {
 			if len(strings.TrimSpace(val)) <= max {
 				continue
 			}
 
-			return newError(fmt.Sprintf("'%s' must not exceed %d characters.", val, max), message...)
+			return newError("max_length", Params{"value": val, "max": max}, message...)
 		}
 
 		return nil
@@ -196,14 +202,13 @@ func MaxLength(max int, message ...string) Validation {
 
 // WithinOptions function validates that values are in the option list.
 func WithinOptions(options []string, message ...string) Validation {
-	return func(values ...string) error {
+	return func(form url.Values, values ...string) error {
 		for _, val := range values {
 			if slices.Contains(options, val) {
 				continue
 			}
 
-			return newError(fmt.Sprintf("'%s' is not in the options.", val), message...)
-
+			return newError("within", Params{"value": val}, message...)
 		}
 
 		return nil
@@ -212,13 +217,13 @@ func WithinOptions(options []string, message ...string) Validation {
 
 // ValidUUID function validates that the values are valid UUIDs.
 func ValidUUID(message ...string) Validation {
-	return func(values ...string) error {
+	return func(form url.Values, values ...string) error {
 		for _, val := range values {
 			if uuid.FromStringOrNil(val) != uuid.Nil {
 				continue
 			}
 
-			return newError(fmt.Sprintf("'%s' is not a valid uuid.", val), message...)
+			return newError("uuid", Params{"value": val}, message...)
 		}
 
 		return nil
@@ -227,18 +232,18 @@ func ValidUUID(message ...string) Validation {
 
 // TimeEqualTo function validates that the values are equal an specific time.
 func TimeEqualTo(u time.Time, message ...string) Validation {
-	return func(values ...string) error {
+	return func(form url.Values, values ...string) error {
 		for _, value := range values {
 			t, err := parseTime(value)
 			if err != nil {
-				return errors.New("is not a time")
+				return newError("not_a_time", Params{"value": value}, message...)
 			}
 
 			if t.Equal(u) {
 				continue
 			}
 
-			return newError(fmt.Sprintf("Time should be equal to '%s'.", u.Format(time.DateOnly)), message...)
+			return newError("time_equal", Params{"value": value, "bound": u}, message...)
 		}
 
 		return nil
@@ -247,18 +252,18 @@ func TimeEqualTo(u time.Time, message ...string) Validation {
 
 // TimeBefore function validates that the values are before an specific time.
 func TimeBefore(u time.Time, message ...string) Validation {
-	return func(values ...string) error {
+	return func(form url.Values, values ...string) error {
 		for _, value := range values {
 			t, err := parseTime(value)
 			if err != nil {
-				return errors.New("is not a time")
+				return newError("not_a_time", Params{"value": value}, message...)
 			}
 
 			if t.Before(u) {
 				continue
 			}
 
-			return newError(fmt.Sprintf("Time should be before than '%s'.", u.Format(time.DateOnly)), message...)
+			return newError("time_before", Params{"value": value, "bound": u}, message...)
 		}
 
 		return nil
@@ -267,18 +272,18 @@ func TimeBefore(u time.Time, message ...string) Validation {
 
 // TimeBeforeOrEqualTo function validates that the values are before or equal to an specific time.
 func TimeBeforeOrEqualTo(u time.Time, message ...string) Validation {
-	return func(values ...string) error {
+	return func(form url.Values, values ...string) error {
 		for _, value := range values {
 			t, err := parseTime(value)
 			if err != nil {
-				return errors.New("is not a time")
+				return newError("not_a_time", Params{"value": value}, message...)
 			}
 
 			if t.Before(u) || t.Equal(u) {
 				continue
 			}
 
-			return newError(fmt.Sprintf("Time should be before or equal to '%s'.", u.Format(time.DateOnly)), message...)
+			return newError("time_before_or_equal", Params{"value": value, "bound": u}, message...)
 		}
 
 		return nil
@@ -287,18 +292,18 @@ func TimeBeforeOrEqualTo(u time.Time, message ...string) Validation {
 
 // TimeAfter function validates that the values are after an specific time.
 func TimeAfter(u time.Time, message ...string) Validation {
-	return func(values ...string) error {
+	return func(form url.Values, values ...string) error {
 		for _, val := range values {
 			t, err := parseTime(val)
 			if err != nil {
-				return newError("invalid time", message...)
+				return newError("not_a_time", Params{"value": val}, message...)
 			}
 
 			if t.After(u) {
 				continue
 			}
 
-			return newError(fmt.Sprintf("Time should be after '%s'.", u.Format(time.DateOnly)), message...)
+			return newError("time_after", Params{"value": val, "bound": u}, message...)
 		}
 
 		return nil
@@ -307,64 +312,47 @@ func TimeAfter(u time.Time, message ...string) Validation {
 
 // TimeAfterOrEqualTo function validates that the values are after or equal to an specific time.
 func TimeAfterOrEqualTo(u time.Time, message ...string) Validation {
-	return func(values ...string) error {
+	return func(form url.Values, values ...string) error {
 		for _, val := range values {
 			t, err := parseTime(val)
 			if err != nil {
-				return newError("invalid time", message...)
+				return newError("not_a_time", Params{"value": val}, message...)
 			}
 
 			if t.After(u) || t.Equal(u) {
 				continue
 			}
 
-			return newError(fmt.Sprintf("Time should be after or equal to '%s'.", u.Format(time.DateOnly)), message...)
+			return newError("time_after_or_equal", Params{"value": val, "bound": u}, message...)
 		}
 
 		return nil
 	}
 }
 
-func parseTime(strTime string) (time.Time, error) {
-	layouts := []string{
-		time.DateOnly,
-		time.Layout,
-		time.ANSIC,
-		time.UnixDate,
-		time.RubyDate,
-		time.RFC822,
-		time.RFC822Z,
-		time.RFC850,
-		time.RFC1123,
-		time.RFC1123Z,
-		time.RFC3339,
-		time.RFC3339Nano,
-		time.Kitchen,
-		time.Stamp,
-		time.StampMilli,
-		time.StampMicro,
-		time.StampNano,
-		time.DateTime,
-		time.TimeOnly,
-	}
-
-	for _, layout := range layouts {
-		t, err := time.Parse(layout, strTime)
-		if err != nil {
-			continue
-		}
+// validationError is the error every built-in Validation returns. It carries a stable message
+// key plus the parameters needed to render it, so a Translator can format it in any language;
+// its Error method falls back to DefaultTranslator for callers (like Struct) that never see a
+// translator explicitly.
+type validationError struct {
+	key      string
+	params   Params
+	override string
+}
 
-		return t, nil
+func (e *validationError) Error() string {
+	if e.override != "" {
+		return e.override
 	}
 
-	return time.Time{}, errors.New("invalid time")
+	return DefaultTranslator.Translate(e.key, e.params)
 }
 
-func newError(message string, override ...string) error {
-	err := message
+func newError(key string, params Params, override ...string) error {
+	var o string
 	if len(override) > 0 {
-		err = override[0]
+		o = override[0]
 	}
 
-	return errors.New(err)
+	return &validationError{key: key, params: params, override: o}
 }